@@ -0,0 +1,59 @@
+package kvbench
+
+import (
+	"context"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+func init() {
+	Register("bolt", &boltBackend{})
+}
+
+var boltBucket = []byte("MyBucket")
+
+type boltBackend struct {
+	db         *bolt.DB
+	durability Durability
+}
+
+func (b *boltBackend) Open(cfg Config) (Backend, error) {
+	dir := cfg.Dir
+	if dir == "" {
+		dir = "my.db"
+	}
+	db, err := bolt.Open(dir, 0600, nil)
+	if err != nil {
+		return nil, err
+	}
+	// NoSync is bbolt's only real durability knob: skip the fsync() that
+	// normally follows every commit.
+	db.NoSync = cfg.Durability == NoFsync
+	return &boltBackend{db: db, durability: cfg.Durability}, nil
+}
+
+func (b *boltBackend) BatchInsert(ctx context.Context, kvs []KV) error {
+	write := func(tx *bolt.Tx) error {
+		bu, err := tx.CreateBucketIfNotExists(boltBucket)
+		if err != nil {
+			return err
+		}
+		for _, kv := range kvs {
+			if err := bu.Put([]byte(kv.Key), []byte(kv.Val)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if b.durability == FsyncGroupCommit {
+		// db.Batch is bbolt's own group-commit mechanism: concurrent
+		// callers are coalesced into a single underlying transaction.
+		return b.db.Batch(write)
+	}
+	return b.db.Update(write)
+}
+
+func (b *boltBackend) Close() error {
+	return b.db.Close()
+}
@@ -0,0 +1,98 @@
+// Package kvbench abstracts the key/value stores this repository
+// benchmarks behind a single Backend interface, so adding a new engine
+// is a matter of registering one more implementation instead of
+// duplicating the benchmark scaffolding for it.
+package kvbench
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// KV is a single key/value pair to be written by a Backend.
+type KV struct {
+	Key string
+	Val string
+}
+
+// Config carries the connection/tuning parameters a Backend needs to
+// open itself. Not every field is meaningful to every backend: file-based
+// stores use Dir, networked ones use DSN. Durability and Unlogged are
+// hints that a Backend maps onto whatever its engine offers; a Backend
+// for which a hint makes no sense is free to ignore it.
+type Config struct {
+	DSN        string
+	Dir        string
+	Durability Durability
+	Unlogged   bool
+}
+
+// Durability selects how hard a Backend should try to make each commit
+// survive a crash, so the cost of that guarantee can be measured
+// separately from the engine or the SQL shape used to write.
+type Durability int
+
+const (
+	// FsyncEveryCommit flushes to durable storage on every commit.
+	FsyncEveryCommit Durability = iota
+	// FsyncGroupCommit lets the engine coalesce fsyncs across commits
+	// when it supports that; backends without a group-commit mode of
+	// their own treat this the same as FsyncEveryCommit.
+	FsyncGroupCommit
+	// NoFsync never waits for a flush, trading durability for speed.
+	NoFsync
+)
+
+func (d Durability) String() string {
+	switch d {
+	case FsyncEveryCommit:
+		return "fsync-every-commit"
+	case FsyncGroupCommit:
+		return "fsync-group-commit"
+	case NoFsync:
+		return "no-fsync"
+	default:
+		return "unknown"
+	}
+}
+
+// Backend is a key/value store capable of bulk-inserting batches of
+// rows. Register a prototype value under a name with Register, then
+// obtain a ready-to-use instance via Open.
+type Backend interface {
+	Open(cfg Config) (Backend, error)
+	BatchInsert(ctx context.Context, kvs []KV) error
+	Close() error
+}
+
+var registry = map[string]Backend{}
+
+// Register makes a backend available under name. It panics on duplicate
+// registration, the same way database/sql drivers do.
+func Register(name string, b Backend) {
+	if _, dup := registry[name]; dup {
+		panic("kvbench: backend " + name + " already registered")
+	}
+	registry[name] = b
+}
+
+// Open looks up the backend registered under name and opens it with cfg.
+func Open(name string, cfg Config) (Backend, error) {
+	proto, ok := registry[name]
+	if !ok {
+		return nil, fmt.Errorf("kvbench: unknown backend %q (have: %v)", name, Names())
+	}
+	return proto.Open(cfg)
+}
+
+// Names returns the sorted list of registered backend names, for flag
+// usage strings and matrix test runners.
+func Names() []string {
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
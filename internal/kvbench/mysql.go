@@ -0,0 +1,58 @@
+package kvbench
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	_ "github.com/go-sql-driver/mysql"
+)
+
+func init() {
+	Register("mysql", &mysqlBackend{})
+}
+
+const mysqlTable = "kvbench_mysql"
+
+type mysqlBackend struct {
+	db *sql.DB
+}
+
+func (m *mysqlBackend) Open(cfg Config) (Backend, error) {
+	db, err := sql.Open("mysql", cfg.DSN)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := db.Exec("create table if not exists " + mysqlTable + "(`key` varchar(20) primary key, val varchar(20))"); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &mysqlBackend{db: db}, nil
+}
+
+func (m *mysqlBackend) BatchInsert(ctx context.Context, kvs []KV) error {
+	txn, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	placeholders := make([]string, len(kvs))
+	args := make([]interface{}, 0, len(kvs)*2)
+	for i, kv := range kvs {
+		placeholders[i] = "(?,?)"
+		args = append(args, kv.Key, kv.Val)
+	}
+
+	query := "insert into " + mysqlTable + " (`key`, val) values " + strings.Join(placeholders, ",") +
+		" on duplicate key update val=values(val)"
+	if _, err := txn.ExecContext(ctx, query, args...); err != nil {
+		txn.Rollback()
+		return err
+	}
+
+	return txn.Commit()
+}
+
+func (m *mysqlBackend) Close() error {
+	return m.db.Close()
+}
@@ -0,0 +1,99 @@
+package kvbench
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+)
+
+func init() {
+	Register("pg", &pgBackend{})
+}
+
+// PgTable and PgTableUnlogged are exported so callers that need to
+// reproduce the schema out of band (e.g. the migration bootstrap in the
+// main package) name the same tables instead of duplicating the string
+// literals and risking drift.
+const (
+	PgTable         = "kvbench_pg"
+	PgTableUnlogged = "kvbench_pg_unlogged"
+)
+
+type pgBackend struct {
+	db         *sql.DB
+	table      string
+	durability Durability
+}
+
+func (p *pgBackend) Open(cfg Config) (Backend, error) {
+	db, err := sql.Open("postgres", cfg.DSN)
+	if err != nil {
+		return nil, err
+	}
+
+	table := PgTable
+	ddl := "create table if not exists " + table + "(key varchar(20) primary key, val varchar(20))"
+	if cfg.Unlogged {
+		table = PgTableUnlogged
+		ddl = "create unlogged table if not exists " + table + "(key varchar(20) primary key, val varchar(20))"
+	}
+	if _, err := db.Exec(ddl); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &pgBackend{db: db, table: table, durability: cfg.Durability}, nil
+}
+
+func (p *pgBackend) BatchInsert(ctx context.Context, kvs []KV) error {
+	txn, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := txn.ExecContext(ctx, "set local synchronous_commit = "+synchronousCommitFor(p.durability)); err != nil {
+		txn.Rollback()
+		return err
+	}
+
+	k := make([]string, len(kvs))
+	v := make([]string, len(kvs))
+	for i, kv := range kvs {
+		k[i] = kv.Key
+		v[i] = kv.Val
+	}
+
+	_, err = txn.ExecContext(ctx, `insert into `+p.table+`
+	select key, val from
+		unnest($1::text[], $2::text[]) v(key, val)
+	on conflict (key) do update set val=excluded.val`,
+		pq.Array(k), pq.Array(v))
+	if err != nil {
+		txn.Rollback()
+		return err
+	}
+
+	return txn.Commit()
+}
+
+func (p *pgBackend) Close() error {
+	return p.db.Close()
+}
+
+// synchronousCommitFor maps our generic Durability axis onto Postgres'
+// own synchronous_commit levels: "on" waits for the local WAL fsync,
+// "local" waits only for the local flush without waiting on replicas,
+// and "off" returns as soon as the WAL is handed to the OS.
+func synchronousCommitFor(d Durability) string {
+	switch d {
+	case FsyncEveryCommit:
+		return "on"
+	case FsyncGroupCommit:
+		return "local"
+	case NoFsync:
+		return "off"
+	default:
+		return "on"
+	}
+}
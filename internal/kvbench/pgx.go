@@ -0,0 +1,82 @@
+package kvbench
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+func init() {
+	Register("pgx", &pgxBackend{})
+}
+
+// PgxTable and PgxTableUnlogged are exported for the same reason as
+// PgTable/PgTableUnlogged: so the migration bootstrap can drop/recreate
+// them without duplicating the literal names.
+const (
+	PgxTable         = "kvbench_pgx"
+	PgxTableUnlogged = "kvbench_pgx_unlogged"
+)
+
+type pgxBackend struct {
+	pool       *pgxpool.Pool
+	table      string
+	durability Durability
+}
+
+func (p *pgxBackend) Open(cfg Config) (Backend, error) {
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, cfg.DSN)
+	if err != nil {
+		return nil, err
+	}
+
+	table := PgxTable
+	ddl := "create table if not exists " + table + "(key varchar(20) primary key, val varchar(20))"
+	if cfg.Unlogged {
+		table = PgxTableUnlogged
+		ddl = "create unlogged table if not exists " + table + "(key varchar(20) primary key, val varchar(20))"
+	}
+	if _, err := pool.Exec(ctx, ddl); err != nil {
+		pool.Close()
+		return nil, err
+	}
+
+	return &pgxBackend{pool: pool, table: table, durability: cfg.Durability}, nil
+}
+
+func (p *pgxBackend) BatchInsert(ctx context.Context, kvs []KV) error {
+	txn, err := p.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := txn.Exec(ctx, "set local synchronous_commit = "+synchronousCommitFor(p.durability)); err != nil {
+		txn.Rollback(ctx)
+		return err
+	}
+
+	k := make([]string, len(kvs))
+	v := make([]string, len(kvs))
+	for i, kv := range kvs {
+		k[i] = kv.Key
+		v[i] = kv.Val
+	}
+
+	_, err = txn.Exec(ctx, `insert into `+p.table+`
+	select key, val from
+		unnest($1::text[], $2::text[]) v(key, val)
+	on conflict (key) do update set val=excluded.val`,
+		k, v)
+	if err != nil {
+		txn.Rollback(ctx)
+		return err
+	}
+
+	return txn.Commit(ctx)
+}
+
+func (p *pgxBackend) Close() error {
+	p.pool.Close()
+	return nil
+}
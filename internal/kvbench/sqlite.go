@@ -0,0 +1,65 @@
+package kvbench
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	_ "modernc.org/sqlite"
+)
+
+func init() {
+	Register("sqlite", &sqliteBackend{})
+}
+
+const sqliteTable = "kvbench_sqlite"
+
+type sqliteBackend struct {
+	db *sql.DB
+}
+
+func (s *sqliteBackend) Open(cfg Config) (Backend, error) {
+	dsn := cfg.DSN
+	if dsn == "" {
+		dsn = cfg.Dir
+	}
+	if dsn == "" {
+		dsn = "kvbench.db"
+	}
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := db.Exec("create table if not exists " + sqliteTable + "(key text primary key, val text)"); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &sqliteBackend{db: db}, nil
+}
+
+func (s *sqliteBackend) BatchInsert(ctx context.Context, kvs []KV) error {
+	txn, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	placeholders := make([]string, len(kvs))
+	args := make([]interface{}, 0, len(kvs)*2)
+	for i, kv := range kvs {
+		placeholders[i] = "(?,?)"
+		args = append(args, kv.Key, kv.Val)
+	}
+
+	query := "insert into " + sqliteTable + " (key, val) values " + strings.Join(placeholders, ",") +
+		" on conflict(key) do update set val=excluded.val"
+	if _, err := txn.ExecContext(ctx, query, args...); err != nil {
+		txn.Rollback()
+		return err
+	}
+
+	return txn.Commit()
+}
+
+func (s *sqliteBackend) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+
+	"github.com/c-pro/insert-bench/internal/kvbench"
+)
+
+// pgMigrations recreates every Postgres table this package writes to,
+// in order: test1..test8 and their COPY staging tables from the lib/pq
+// and pgx benchmarks, plus the kvbench_pg/kvbench_pgx tables the generic
+// BenchmarkBackend harness uses. Running any of them against a database
+// that already has rows (or a warmed-up b-tree/toast) skews later runs,
+// since their own CREATE TABLE IF NOT EXISTS never cleans anything up.
+var pgMigrations = []string{
+	"drop table if exists test1",
+	"drop table if exists test2",
+	"drop table if exists test3",
+	"drop table if exists test4",
+	"drop table if exists test5",
+	"drop table if exists test6",
+	"drop table if exists test7",
+	"drop table if exists test8",
+	"drop table if exists test1_staging",
+	"drop table if exists test2_staging",
+	"drop table if exists test7_staging",
+	"drop table if exists " + kvbench.PgTable,
+	"drop table if exists " + kvbench.PgTableUnlogged,
+	"drop table if exists " + kvbench.PgxTable,
+	"drop table if exists " + kvbench.PgxTableUnlogged,
+
+	"create table test1(key varchar(20) primary key, val varchar(20))",
+	"create unlogged table test2(key varchar(20) primary key, val varchar(20))",
+	"create table test3(key varchar(20) primary key, val varchar(20))",
+	"create unlogged table test4(key varchar(20) primary key, val varchar(20))",
+	"create table test5(key varchar(20) primary key, val varchar(20))",
+	"create table test6(key varchar(20) primary key, val varchar(20))",
+	"create table test7(key varchar(20) primary key, val varchar(20))",
+	"create table test8(key varchar(20) primary key, val varchar(20))",
+	"create unlogged table test1_staging(key varchar(20), val varchar(20))",
+	"create unlogged table test2_staging(key varchar(20), val varchar(20))",
+	"create unlogged table test7_staging(key varchar(20), val varchar(20))",
+	"create table " + kvbench.PgTable + "(key varchar(20) primary key, val varchar(20))",
+	"create unlogged table " + kvbench.PgTableUnlogged + "(key varchar(20) primary key, val varchar(20))",
+	"create table " + kvbench.PgxTable + "(key varchar(20) primary key, val varchar(20))",
+	"create unlogged table " + kvbench.PgxTableUnlogged + "(key varchar(20) primary key, val varchar(20))",
+}
+
+// runPgMigrations drops and recreates the tables above before the
+// benchmark run. It's destructive, so it only runs when
+// INSERT_BENCH_ALLOW_DESTROY=1 is set; otherwise every benchmark falls
+// back to its own CREATE TABLE IF NOT EXISTS and the caller is
+// responsible for knowing the numbers may be skewed by leftover rows.
+func runPgMigrations() error {
+	if os.Getenv("INSERT_BENCH_ALLOW_DESTROY") != "1" {
+		fmt.Fprintln(os.Stderr, "insert-bench: skipping destructive Pg migrations (set INSERT_BENCH_ALLOW_DESTROY=1 to drop/recreate test1..test8 and kvbench_pg/kvbench_pgx)")
+		return nil
+	}
+
+	db, err := sql.Open("postgres", pgDSN)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	for _, stmt := range pgMigrations {
+		if _, err := db.Exec(stmt); err != nil {
+			return fmt.Errorf("migration %q: %w", stmt, err)
+		}
+	}
+
+	if os.Getenv("INSERT_BENCH_VACUUM") == "1" {
+		if _, err := db.Exec("vacuum full; analyze"); err != nil {
+			return fmt.Errorf("vacuum: %w", err)
+		}
+	}
+
+	return nil
+}